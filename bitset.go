@@ -0,0 +1,205 @@
+package sparsesets
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// BitSet is a companion to Set that represents a set of integers from 0 to
+// N-1 as a bitmap instead of a pair of index arrays. It implements the same
+// Insert/Remove/Contains/Size/Content/Clear interface as Set (see IntSet),
+// trading Set's O(1) Clear and Content for word-parallel bulk operations and
+// roughly N/64 words of memory instead of 2*N ints. BitSet is the better fit
+// when N is large, the set is dense, or bulk operations (UnionWith and
+// friends) dominate.
+//
+// The zero value is not a usable BitSet; use NewBitSet.
+type BitSet struct {
+	n     int
+	count int
+	words []uint64
+}
+
+// NewBitSet creates and initializes a new empty BitSet for elements from 0 to
+// N-1.
+//
+// NewBitSet panics if n is negative.
+func NewBitSet(n int) *BitSet {
+	if n < 0 {
+		panic(fmt.Sprintf("negative capacity n: %d", n))
+	}
+	return &BitSet{
+		n:     n,
+		words: make([]uint64, (n+63)/64),
+	}
+}
+
+// N returns the capacity of the bit set.
+func (bs *BitSet) N() int {
+	return bs.n
+}
+
+// Size returns the number of elements contained in the bit set.
+func (bs *BitSet) Size() int {
+	return bs.count
+}
+
+// Contains returns true if elem is in the set; it returns false otherwise.
+func (bs *BitSet) Contains(elem int) bool {
+	return bs.words[elem/64]&(1<<uint(elem%64)) != 0
+}
+
+// Insert inserts elem in the set. It returns an error if elem is out of the
+// set's range [0, N).
+func (bs *BitSet) Insert(elem int) error {
+	if elem < 0 || elem >= bs.n {
+		return fmt.Errorf("elem %d is out of the set range [0, %d)", elem, bs.n)
+	}
+	w, b := elem/64, uint(elem%64)
+	if bs.words[w]&(1<<b) == 0 {
+		bs.words[w] |= 1 << b
+		bs.count++
+	}
+	return nil
+}
+
+// Remove removes elem from the set. It returns an error if elem is out of
+// the set's range [0, N).
+func (bs *BitSet) Remove(elem int) error {
+	if elem < 0 || elem >= bs.n {
+		return fmt.Errorf("elem %d is out of the set range [0, %d)", elem, bs.n)
+	}
+	w, b := elem/64, uint(elem%64)
+	if bs.words[w]&(1<<b) != 0 {
+		bs.words[w] &^= 1 << b
+		bs.count--
+	}
+	return nil
+}
+
+// Clear empties the set in O(N/64).
+func (bs *BitSet) Clear() {
+	for i := range bs.words {
+		bs.words[i] = 0
+	}
+	bs.count = 0
+}
+
+// Content returns a newly allocated slice containing the current elements of
+// the set, in increasing order. Unlike Set.Content, this is not a view of
+// the bit set's internal storage, since a bitmap has no index array to
+// expose: building it scans every word, costing O(N/64 + size).
+func (bs *BitSet) Content() []int {
+	elems := make([]int, 0, bs.count)
+	for wi, w := range bs.words {
+		for w != 0 {
+			tz := bits.TrailingZeros64(w)
+			elems = append(elems, wi*64+tz)
+			w &= w - 1
+		}
+	}
+	return elems
+}
+
+// TakeMin removes and returns the smallest element of the set. It returns
+// false if the set is empty. TakeMin runs in O(N/64).
+func (bs *BitSet) TakeMin() (int, bool) {
+	for wi, w := range bs.words {
+		if w != 0 {
+			tz := bits.TrailingZeros64(w)
+			bs.words[wi] &^= 1 << uint(tz)
+			bs.count--
+			return wi*64 + tz, true
+		}
+	}
+	return 0, false
+}
+
+// UnionWith sets the receiver to the union of itself and other, i.e. it
+// inserts every element of other into bs. It returns true if bs was modified
+// as a result. UnionWith is word-parallel and runs in O(N/64).
+//
+// UnionWith panics if bs and other do not share the same capacity N.
+func (bs *BitSet) UnionWith(other *BitSet) bool {
+	bs.checkSameCapacity(other)
+	changed := false
+	for i, w := range bs.words {
+		nv := w | other.words[i]
+		if nv != w {
+			bs.count += bits.OnesCount64(nv) - bits.OnesCount64(w)
+			bs.words[i] = nv
+			changed = true
+		}
+	}
+	return changed
+}
+
+// IntersectionWith sets the receiver to the intersection of itself and
+// other, i.e. it removes every element of bs that is not also in other. It
+// returns true if bs was modified as a result. IntersectionWith is
+// word-parallel and runs in O(N/64).
+//
+// IntersectionWith panics if bs and other do not share the same capacity N.
+func (bs *BitSet) IntersectionWith(other *BitSet) bool {
+	bs.checkSameCapacity(other)
+	changed := false
+	for i, w := range bs.words {
+		nv := w & other.words[i]
+		if nv != w {
+			bs.count += bits.OnesCount64(nv) - bits.OnesCount64(w)
+			bs.words[i] = nv
+			changed = true
+		}
+	}
+	return changed
+}
+
+// DifferenceWith sets the receiver to the difference of itself and other,
+// i.e. it removes every element of other from bs. It returns true if bs was
+// modified as a result. DifferenceWith is word-parallel and runs in
+// O(N/64).
+//
+// DifferenceWith panics if bs and other do not share the same capacity N.
+func (bs *BitSet) DifferenceWith(other *BitSet) bool {
+	bs.checkSameCapacity(other)
+	changed := false
+	for i, w := range bs.words {
+		nv := w &^ other.words[i]
+		if nv != w {
+			bs.count += bits.OnesCount64(nv) - bits.OnesCount64(w)
+			bs.words[i] = nv
+			changed = true
+		}
+	}
+	return changed
+}
+
+// SymmetricDifferenceWith sets the receiver to the symmetric difference of
+// itself and other, i.e. bs ends up containing the elements that are in
+// exactly one of the two sets. It returns true if bs was modified as a
+// result. SymmetricDifferenceWith is word-parallel and runs in O(N/64).
+//
+// SymmetricDifferenceWith panics if bs and other do not share the same
+// capacity N.
+func (bs *BitSet) SymmetricDifferenceWith(other *BitSet) bool {
+	bs.checkSameCapacity(other)
+	changed := false
+	for i, w := range bs.words {
+		nv := w ^ other.words[i]
+		if nv != w {
+			bs.count += bits.OnesCount64(nv) - bits.OnesCount64(w)
+			bs.words[i] = nv
+			changed = true
+		}
+	}
+	return changed
+}
+
+// checkSameCapacity panics if bs and other do not share the same capacity N,
+// since the word-parallel bulk operations assume both sets use the same
+// number of words.
+func (bs *BitSet) checkSameCapacity(other *BitSet) {
+	if bs.n != other.n {
+		panic(fmt.Sprintf("mismatched set capacities: %d != %d", bs.n, other.n))
+	}
+}
@@ -0,0 +1,207 @@
+package sparsesets
+
+import (
+	"sort"
+	"testing"
+)
+
+func bitSetOf(n int, elems ...int) *BitSet {
+	bs := NewBitSet(n)
+	for _, e := range elems {
+		bs.Insert(e)
+	}
+	return bs
+}
+
+func TestBitSet_InsertRemoveContains(t *testing.T) {
+	bs := NewBitSet(130) // exercises more than two words.
+
+	if bs.Contains(65) {
+		t.Fatalf("Contains(65) = true on empty set")
+	}
+	if err := bs.Insert(65); err != nil {
+		t.Fatalf("Insert(65): %s", err)
+	}
+	if !bs.Contains(65) {
+		t.Errorf("Contains(65) = false after Insert(65)")
+	}
+	if got := bs.Size(); got != 1 {
+		t.Errorf("Size() = %d, want 1", got)
+	}
+
+	if err := bs.Insert(65); err != nil {
+		t.Fatalf("Insert(65) again: %s", err)
+	}
+	if got := bs.Size(); got != 1 {
+		t.Errorf("Size() after re-Insert = %d, want 1", got)
+	}
+
+	if err := bs.Remove(65); err != nil {
+		t.Fatalf("Remove(65): %s", err)
+	}
+	if bs.Contains(65) {
+		t.Errorf("Contains(65) = true after Remove(65)")
+	}
+	if got := bs.Size(); got != 0 {
+		t.Errorf("Size() after Remove = %d, want 0", got)
+	}
+}
+
+func TestBitSet_Insert_error(t *testing.T) {
+	bs := NewBitSet(5)
+	if err := bs.Insert(5); err == nil {
+		t.Errorf("Insert(5): want error, got nil")
+	}
+}
+
+func TestBitSet_Remove_error(t *testing.T) {
+	bs := NewBitSet(5)
+	if err := bs.Remove(5); err == nil {
+		t.Errorf("Remove(5): want error, got nil")
+	}
+}
+
+func TestBitSet_Content(t *testing.T) {
+	bs := bitSetOf(130, 0, 64, 65, 129)
+	got := bs.Content()
+	sort.Ints(got)
+	if want := []int{0, 64, 65, 129}; !equalInts(got, want) {
+		t.Errorf("Content() = %v, want %v", got, want)
+	}
+}
+
+func TestBitSet_Clear(t *testing.T) {
+	bs := bitSetOf(130, 0, 64, 129)
+	bs.Clear()
+	if got := bs.Size(); got != 0 {
+		t.Errorf("Size() after Clear = %d, want 0", got)
+	}
+	if len(bs.Content()) != 0 {
+		t.Errorf("Content() after Clear = %v, want empty", bs.Content())
+	}
+}
+
+func TestBitSet_TakeMin(t *testing.T) {
+	bs := bitSetOf(130, 64, 3, 129, 0)
+
+	var got []int
+	for {
+		elem, ok := bs.TakeMin()
+		if !ok {
+			break
+		}
+		got = append(got, elem)
+	}
+
+	if want := []int{0, 3, 64, 129}; !equalInts(got, want) {
+		t.Errorf("TakeMin() sequence = %v, want %v", got, want)
+	}
+	if got := bs.Size(); got != 0 {
+		t.Errorf("Size() after draining with TakeMin = %d, want 0", got)
+	}
+}
+
+func TestBitSet_TakeMin_empty(t *testing.T) {
+	bs := NewBitSet(5)
+	if _, ok := bs.TakeMin(); ok {
+		t.Errorf("TakeMin() on empty set: want ok = false")
+	}
+}
+
+func TestBitSet_UnionWith(t *testing.T) {
+	a := bitSetOf(130, 0, 64)
+	b := bitSetOf(130, 64, 129)
+
+	if changed := a.UnionWith(b); !changed {
+		t.Errorf("UnionWith() changed = false, want true")
+	}
+	got := a.Content()
+	sort.Ints(got)
+	if want := []int{0, 64, 129}; !equalInts(got, want) {
+		t.Errorf("UnionWith() content = %v, want %v", got, want)
+	}
+
+	if changed := a.UnionWith(b); changed {
+		t.Errorf("UnionWith() idempotent call changed = true, want false")
+	}
+}
+
+func TestBitSet_IntersectionWith(t *testing.T) {
+	a := bitSetOf(130, 0, 64, 129)
+	b := bitSetOf(130, 64, 129)
+
+	if changed := a.IntersectionWith(b); !changed {
+		t.Errorf("IntersectionWith() changed = false, want true")
+	}
+	got := a.Content()
+	sort.Ints(got)
+	if want := []int{64, 129}; !equalInts(got, want) {
+		t.Errorf("IntersectionWith() content = %v, want %v", got, want)
+	}
+}
+
+func TestBitSet_DifferenceWith(t *testing.T) {
+	a := bitSetOf(130, 0, 64, 129)
+	b := bitSetOf(130, 64)
+
+	if changed := a.DifferenceWith(b); !changed {
+		t.Errorf("DifferenceWith() changed = false, want true")
+	}
+	got := a.Content()
+	sort.Ints(got)
+	if want := []int{0, 129}; !equalInts(got, want) {
+		t.Errorf("DifferenceWith() content = %v, want %v", got, want)
+	}
+}
+
+func TestBitSet_SymmetricDifferenceWith(t *testing.T) {
+	a := bitSetOf(130, 0, 64, 129)
+	b := bitSetOf(130, 64, 1)
+
+	if changed := a.SymmetricDifferenceWith(b); !changed {
+		t.Errorf("SymmetricDifferenceWith() changed = false, want true")
+	}
+	got := a.Content()
+	sort.Ints(got)
+	if want := []int{0, 1, 129}; !equalInts(got, want) {
+		t.Errorf("SymmetricDifferenceWith() content = %v, want %v", got, want)
+	}
+}
+
+func TestBitSet_bulkOps_mismatchedCapacityPanics(t *testing.T) {
+	ops := map[string]func(a, b *BitSet) bool{
+		"UnionWith":               (*BitSet).UnionWith,
+		"IntersectionWith":        (*BitSet).IntersectionWith,
+		"DifferenceWith":          (*BitSet).DifferenceWith,
+		"SymmetricDifferenceWith": (*BitSet).SymmetricDifferenceWith,
+	}
+	for name, op := range ops {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: want panic on mismatched capacities, got none", name)
+				}
+			}()
+			op(NewBitSet(5), NewBitSet(70))
+		})
+	}
+}
+
+func TestIntSet_implementations(t *testing.T) {
+	var sets = []IntSet{New(5), NewBitSet(5)}
+	for _, s := range sets {
+		if err := s.Insert(2); err != nil {
+			t.Fatalf("Insert(2): %s", err)
+		}
+		if !s.Contains(2) {
+			t.Errorf("Contains(2) = false after Insert(2)")
+		}
+		if got := s.Size(); got != 1 {
+			t.Errorf("Size() = %d, want 1", got)
+		}
+		s.Clear()
+		if got := s.Size(); got != 0 {
+			t.Errorf("Size() after Clear = %d, want 0", got)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package sparsesets
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// MarshalBinary encodes the set as: a varint N, a varint size, followed by
+// size varints listing the set's members in arbitrary order. The underlying
+// permutation is not observable and is therefore not encoded; a fresh set
+// restored from this encoding is built by inserting the members, in order,
+// into a new Set(N).
+//
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (ss *Set) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, binary.MaxVarintLen64*(2+ss.Size()))
+	var tmp [binary.MaxVarintLen64]byte
+
+	n := binary.PutVarint(tmp[:], int64(ss.N()))
+	buf = append(buf, tmp[:n]...)
+
+	n = binary.PutVarint(tmp[:], int64(ss.Size()))
+	buf = append(buf, tmp[:n]...)
+
+	for _, v := range ss.Content() {
+		n = binary.PutVarint(tmp[:], int64(v))
+		buf = append(buf, tmp[:n]...)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a set previously encoded with MarshalBinary,
+// replacing the receiver's contents. It rejects malformed data: a negative
+// N, a size that does not fit N, a member outside [0, N), or a duplicate
+// member.
+//
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (ss *Set) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+
+	n, err := binary.ReadVarint(r)
+	if err != nil {
+		return fmt.Errorf("sparsesets: invalid binary data: reading N: %w", err)
+	}
+	if n < 0 {
+		return fmt.Errorf("sparsesets: invalid binary data: negative N %d", n)
+	}
+
+	size, err := binary.ReadVarint(r)
+	if err != nil {
+		return fmt.Errorf("sparsesets: invalid binary data: reading size: %w", err)
+	}
+	if size < 0 || size > n {
+		return fmt.Errorf("sparsesets: invalid binary data: size %d out of range [0, %d]", size, n)
+	}
+
+	decoded := New(int(n))
+	for i := int64(0); i < size; i++ {
+		v, err := binary.ReadVarint(r)
+		if err != nil {
+			return fmt.Errorf("sparsesets: invalid binary data: reading member %d: %w", i, err)
+		}
+		if v < 0 || v >= n {
+			return fmt.Errorf("sparsesets: invalid binary data: member %d out of range [0, %d)", v, n)
+		}
+		if decoded.Contains(int(v)) {
+			return fmt.Errorf("sparsesets: invalid binary data: duplicate member %d", v)
+		}
+		decoded.Insert(int(v))
+	}
+	if r.Len() != 0 {
+		return fmt.Errorf("sparsesets: invalid binary data: %d trailing byte(s)", r.Len())
+	}
+
+	*ss = *decoded
+	return nil
+}
+
+// jsonSet is the on-the-wire JSON representation of a Set: {"n": N,
+// "members": [...]}.
+type jsonSet struct {
+	N       int   `json:"n"`
+	Members []int `json:"members"`
+}
+
+// MarshalJSON encodes the set as {"n": N, "members": [...]}, with members
+// listed in increasing order for readability.
+//
+// MarshalJSON implements json.Marshaler.
+func (ss *Set) MarshalJSON() ([]byte, error) {
+	members := append([]int(nil), ss.Content()...)
+	sort.Ints(members)
+	return json.Marshal(jsonSet{N: ss.N(), Members: members})
+}
+
+// UnmarshalJSON decodes a set previously encoded with MarshalJSON, replacing
+// the receiver's contents. It rejects malformed data: a negative N, a member
+// outside [0, N), or a duplicate member.
+//
+// UnmarshalJSON implements json.Unmarshaler.
+func (ss *Set) UnmarshalJSON(data []byte) error {
+	var raw jsonSet
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("sparsesets: invalid JSON data: %w", err)
+	}
+	if raw.N < 0 {
+		return fmt.Errorf("sparsesets: invalid JSON data: negative n %d", raw.N)
+	}
+
+	decoded := New(raw.N)
+	for _, v := range raw.Members {
+		if v < 0 || v >= raw.N {
+			return fmt.Errorf("sparsesets: invalid JSON data: member %d out of range [0, %d)", v, raw.N)
+		}
+		if decoded.Contains(v) {
+			return fmt.Errorf("sparsesets: invalid JSON data: duplicate member %d", v)
+		}
+		decoded.Insert(v)
+	}
+
+	*ss = *decoded
+	return nil
+}
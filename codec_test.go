@@ -0,0 +1,203 @@
+package sparsesets
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/rand"
+	"testing"
+)
+
+func TestSet_MarshalBinary_UnmarshalBinary(t *testing.T) {
+	ss := setOf(5, 1, 3, 4)
+
+	data, err := ss.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary(): %s", err)
+	}
+
+	var got Set
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary(): %s", err)
+	}
+	if !got.Equals(ss) {
+		t.Errorf("round trip = %v, want %v", sortedContent(&got), sortedContent(ss))
+	}
+	if got.N() != ss.N() {
+		t.Errorf("N() after round trip = %d, want %d", got.N(), ss.N())
+	}
+}
+
+func TestSet_UnmarshalBinary_malformed(t *testing.T) {
+	validN5 := setOf(5, 1, 2)
+	data, err := validN5.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary(): %s", err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"truncated", data[:len(data)-1]},
+		{"garbage", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"empty", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ss Set
+			if err := ss.UnmarshalBinary(tt.data); err == nil {
+				t.Errorf("UnmarshalBinary(): want error, got nil")
+			}
+		})
+	}
+}
+
+func TestSet_UnmarshalBinary_rejectsSemanticErrors(t *testing.T) {
+	encode := func(n, size int, members ...int) []byte {
+		var buf []byte
+		buf = binary.AppendVarint(buf, int64(n))
+		buf = binary.AppendVarint(buf, int64(size))
+		for _, m := range members {
+			buf = binary.AppendVarint(buf, int64(m))
+		}
+		return buf
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"negative n", encode(-1, 0)},
+		{"size bigger than n", encode(3, 4)},
+		{"member out of range", encode(3, 1, 3)},
+		{"duplicate member", encode(3, 2, 1, 1)},
+		{"trailing data", append(encode(3, 1, 1), 0x01, 0x02)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ss Set
+			if err := ss.UnmarshalBinary(tt.data); err == nil {
+				t.Errorf("UnmarshalBinary(): want error, got nil")
+			}
+		})
+	}
+}
+
+func FuzzSet_BinaryRoundTrip(f *testing.F) {
+	f.Add(5, int64(1))
+	f.Add(0, int64(2))
+	f.Add(100, int64(42))
+
+	f.Fuzz(func(t *testing.T, n int, seed int64) {
+		if n < 0 || n > 1000 {
+			t.Skip()
+		}
+		r := rand.New(rand.NewSource(seed))
+		ss := New(n)
+		for i := 0; i < n; i++ {
+			if r.Intn(2) == 0 {
+				ss.Insert(i)
+			}
+		}
+
+		data, err := ss.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(): %s", err)
+		}
+		var got Set
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(): %s", err)
+		}
+		if !got.Equals(ss) {
+			t.Errorf("round trip = %v, want %v", sortedContent(&got), sortedContent(ss))
+		}
+	})
+}
+
+func TestSet_MarshalJSON_UnmarshalJSON(t *testing.T) {
+	ss := setOf(5, 1, 3, 4)
+
+	data, err := ss.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON(): %s", err)
+	}
+
+	var got Set
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON(): %s", err)
+	}
+	if !got.Equals(ss) {
+		t.Errorf("round trip = %v, want %v", sortedContent(&got), sortedContent(ss))
+	}
+}
+
+func TestSet_MarshalJSON_format(t *testing.T) {
+	ss := setOf(5, 3, 1)
+
+	data, err := ss.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON(): %s", err)
+	}
+
+	var raw jsonSet
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("json.Unmarshal(): %s", err)
+	}
+	if raw.N != 5 {
+		t.Errorf("n = %d, want 5", raw.N)
+	}
+	if want := []int{1, 3}; !equalInts(raw.Members, want) {
+		t.Errorf("members = %v, want %v", raw.Members, want)
+	}
+}
+
+func TestSet_UnmarshalJSON_rejectsSemanticErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"malformed json", `{`},
+		{"negative n", `{"n": -1, "members": []}`},
+		{"member out of range", `{"n": 3, "members": [3]}`},
+		{"duplicate member", `{"n": 3, "members": [1, 1]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ss Set
+			if err := ss.UnmarshalJSON([]byte(tt.json)); err == nil {
+				t.Errorf("UnmarshalJSON(): want error, got nil")
+			}
+		})
+	}
+}
+
+func FuzzSet_JSONRoundTrip(f *testing.F) {
+	f.Add(5, int64(1))
+	f.Add(0, int64(2))
+	f.Add(100, int64(42))
+
+	f.Fuzz(func(t *testing.T, n int, seed int64) {
+		if n < 0 || n > 1000 {
+			t.Skip()
+		}
+		r := rand.New(rand.NewSource(seed))
+		ss := New(n)
+		for i := 0; i < n; i++ {
+			if r.Intn(2) == 0 {
+				ss.Insert(i)
+			}
+		}
+
+		data, err := ss.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON(): %s", err)
+		}
+		var got Set
+		if err := got.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(): %s", err)
+		}
+		if !got.Equals(ss) {
+			t.Errorf("round trip = %v, want %v", sortedContent(&got), sortedContent(ss))
+		}
+	})
+}
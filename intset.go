@@ -0,0 +1,38 @@
+package sparsesets
+
+// IntSet is the common interface implemented by both Set and BitSet. It lets
+// callers pick the representation best suited to their workload — Set for
+// sparse, incrementally-built sets with O(1) Clear and fast iteration of the
+// present members, BitSet for dense sets or workloads dominated by bulk
+// operations — while coding against a single type.
+type IntSet interface {
+	// N returns the capacity of the set, i.e. the range of possible values
+	// (0 to N-1) that it can contain.
+	N() int
+
+	// Size returns the number of elements contained in the set.
+	Size() int
+
+	// Insert inserts elem in the set. It returns an error if elem is out of
+	// the set's range.
+	Insert(elem int) error
+
+	// Remove removes elem from the set. It returns an error if elem is out
+	// of the set's range.
+	Remove(elem int) error
+
+	// Contains returns true if elem is in the set; it returns false
+	// otherwise.
+	Contains(elem int) bool
+
+	// Content returns a slice containing the current elements of the set.
+	Content() []int
+
+	// Clear empties the set.
+	Clear()
+}
+
+var (
+	_ IntSet = (*Set)(nil)
+	_ IntSet = (*BitSet)(nil)
+)
@@ -0,0 +1,84 @@
+package sparsesets
+
+// KeyedSet is a set of arbitrary comparable keys, built on top of Set by
+// interning each key into a compact integer index. It offers the same O(1)
+// Add/Delete/Has and cheap iteration of present members as Set, making it a
+// drop-in replacement for the common map[T]struct{} idiom when those
+// properties matter.
+//
+// The zero value is not a usable KeyedSet; use NewKeyedSet.
+type KeyedSet[T comparable] struct {
+	index    *Set
+	interner map[T]int
+	keys     []T
+}
+
+// NewKeyedSet creates and initializes a new empty KeyedSet.
+func NewKeyedSet[T comparable]() *KeyedSet[T] {
+	return &KeyedSet[T]{
+		index:    New(0),
+		interner: make(map[T]int),
+	}
+}
+
+// Add inserts key into the set. If key has not been seen before, it is
+// interned to a new index, growing the underlying Set if needed.
+func (ks *KeyedSet[T]) Add(key T) {
+	idx, ok := ks.interner[key]
+	if !ok {
+		idx = len(ks.keys)
+		ks.interner[key] = idx
+		ks.keys = append(ks.keys, key)
+		if idx >= ks.index.N() {
+			ks.index.Grow(nextCapacity(ks.index.N()))
+		}
+	}
+	ks.index.Insert(idx)
+}
+
+// Delete removes key from the set. It is a no-op if key is not in the set,
+// including if key has never been seen by the set.
+func (ks *KeyedSet[T]) Delete(key T) {
+	idx, ok := ks.interner[key]
+	if !ok || !ks.index.Contains(idx) {
+		return
+	}
+	ks.index.Remove(idx)
+}
+
+// Has returns true if key is in the set; it returns false otherwise.
+func (ks *KeyedSet[T]) Has(key T) bool {
+	idx, ok := ks.interner[key]
+	return ok && ks.index.Contains(idx)
+}
+
+// Size returns the number of keys contained in the set.
+func (ks *KeyedSet[T]) Size() int {
+	return ks.index.Size()
+}
+
+// Clear empties the set in constant time. Keys interned so far remain
+// interned, so adding them back afterwards does not re-grow the set.
+func (ks *KeyedSet[T]) Clear() {
+	ks.index.Clear()
+}
+
+// Range calls f for each key currently in the set, in unspecified order. It
+// stops early if f returns false.
+func (ks *KeyedSet[T]) Range(f func(key T) bool) {
+	for _, idx := range ks.index.Content() {
+		if !f(ks.keys[idx]) {
+			return
+		}
+	}
+}
+
+// nextCapacity returns the capacity a Set backing a KeyedSet should grow to
+// in order to fit at least one more interned key, amortizing the cost of
+// growth by doubling.
+func nextCapacity(n int) int {
+	if n == 0 {
+		return 1
+	}
+	return 2 * n
+}
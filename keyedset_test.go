@@ -0,0 +1,121 @@
+package sparsesets
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestKeyedSet_AddHasDelete(t *testing.T) {
+	ks := NewKeyedSet[string]()
+
+	if ks.Has("a") {
+		t.Fatalf("Has(%q) = true on empty set", "a")
+	}
+
+	ks.Add("a")
+	ks.Add("b")
+	if !ks.Has("a") || !ks.Has("b") {
+		t.Fatalf("Has() = false after Add, want true")
+	}
+	if got := ks.Size(); got != 2 {
+		t.Errorf("Size() = %d, want 2", got)
+	}
+
+	ks.Delete("a")
+	if ks.Has("a") {
+		t.Errorf("Has(%q) = true after Delete, want false", "a")
+	}
+	if !ks.Has("b") {
+		t.Errorf("Has(%q) = false after unrelated Delete, want true", "b")
+	}
+	if got := ks.Size(); got != 1 {
+		t.Errorf("Size() after Delete = %d, want 1", got)
+	}
+}
+
+func TestKeyedSet_Add_idempotent(t *testing.T) {
+	ks := NewKeyedSet[int]()
+	ks.Add(42)
+	ks.Add(42)
+	if got := ks.Size(); got != 1 {
+		t.Errorf("Size() after re-Add = %d, want 1", got)
+	}
+}
+
+func TestKeyedSet_Delete_unseenKey(t *testing.T) {
+	ks := NewKeyedSet[string]()
+	ks.Delete("never-added") // must not panic.
+	if got := ks.Size(); got != 0 {
+		t.Errorf("Size() = %d, want 0", got)
+	}
+}
+
+func TestKeyedSet_growsPastInitialCapacity(t *testing.T) {
+	ks := NewKeyedSet[int]()
+	const n = 1000
+	for i := 0; i < n; i++ {
+		ks.Add(i)
+	}
+	if got := ks.Size(); got != n {
+		t.Fatalf("Size() = %d, want %d", got, n)
+	}
+	for i := 0; i < n; i++ {
+		if !ks.Has(i) {
+			t.Fatalf("Has(%d) = false, want true", i)
+		}
+	}
+}
+
+func TestKeyedSet_Clear(t *testing.T) {
+	ks := NewKeyedSet[string]()
+	ks.Add("a")
+	ks.Add("b")
+	ks.Clear()
+
+	if got := ks.Size(); got != 0 {
+		t.Errorf("Size() after Clear = %d, want 0", got)
+	}
+	if ks.Has("a") {
+		t.Errorf("Has(%q) after Clear = true, want false", "a")
+	}
+
+	// Keys interned before Clear should not need to re-grow the set.
+	ks.Add("a")
+	if !ks.Has("a") {
+		t.Errorf("Has(%q) after re-Add = false, want true", "a")
+	}
+}
+
+func TestKeyedSet_Range(t *testing.T) {
+	ks := NewKeyedSet[string]()
+	ks.Add("a")
+	ks.Add("b")
+	ks.Add("c")
+	ks.Delete("b")
+
+	var got []string
+	ks.Range(func(key string) bool {
+		got = append(got, key)
+		return true
+	})
+	sort.Strings(got)
+	if want := []string{"a", "c"}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Range() visited = %v, want %v", got, want)
+	}
+}
+
+func TestKeyedSet_Range_stopsEarly(t *testing.T) {
+	ks := NewKeyedSet[int]()
+	for i := 0; i < 10; i++ {
+		ks.Add(i)
+	}
+
+	visited := 0
+	ks.Range(func(key int) bool {
+		visited++
+		return false
+	})
+	if visited != 1 {
+		t.Errorf("Range() visited %d elements, want 1", visited)
+	}
+}
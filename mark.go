@@ -0,0 +1,25 @@
+package sparsesets
+
+// Mark returns an integer marker representing the set's current size. Pair
+// it with a later call to RemovedSince to discover exactly which elements
+// were removed from the set in between. Mark runs in O(1).
+func (ss *Set) Mark() int {
+	return ss.size
+}
+
+// RemovedSince returns the elements that were removed from the set between
+// the call to Mark that produced mark and now. It runs in O(delta), where
+// delta is the length of the returned slice, which lets propagators in a
+// constraint solver react to exactly the domain changes that occurred since
+// the last time they observed the set.
+//
+// RemovedSince assumes the set has only shrunk since mark was taken, i.e.
+// that no element was inserted back into it in the meantime; its result is
+// unspecified otherwise.
+//
+// Important: as with Content and Absent, the returned slice is a direct view
+// of the set's internal structure and is only valid for read-only use until
+// the next call to Insert or Remove.
+func (ss *Set) RemovedSince(mark int) []int {
+	return ss.values[ss.size:mark]
+}
@@ -0,0 +1,59 @@
+package sparsesets
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestSet_Mark_RemovedSince(t *testing.T) {
+	ss := setOf(5, 0, 1, 2, 3, 4)
+
+	mark := ss.Mark()
+	if got := ss.RemovedSince(mark); len(got) != 0 {
+		t.Fatalf("RemovedSince(mark) before any removal = %v, want empty", got)
+	}
+
+	ss.Remove(1)
+	ss.Remove(3)
+
+	if got := sortedContent(ss); !equalInts(got, []int{0, 2, 4}) {
+		t.Fatalf("content after removals = %v, want %v", got, []int{0, 2, 4})
+	}
+
+	removed := append([]int(nil), ss.RemovedSince(mark)...)
+	sort.Ints(removed)
+	if !equalInts(removed, []int{1, 3}) {
+		t.Errorf("RemovedSince(mark) = %v, want %v", removed, []int{1, 3})
+	}
+}
+
+func TestSet_Mark_RemovedSince_incremental(t *testing.T) {
+	ss := setOf(5, 0, 1, 2, 3, 4)
+
+	mark1 := ss.Mark()
+	ss.Remove(0)
+
+	mark2 := ss.Mark()
+	ss.Remove(2)
+	ss.Remove(4)
+
+	removedSinceMark2 := append([]int(nil), ss.RemovedSince(mark2)...)
+	sort.Ints(removedSinceMark2)
+	if !equalInts(removedSinceMark2, []int{2, 4}) {
+		t.Errorf("RemovedSince(mark2) = %v, want %v", removedSinceMark2, []int{2, 4})
+	}
+
+	removedSinceMark1 := append([]int(nil), ss.RemovedSince(mark1)...)
+	sort.Ints(removedSinceMark1)
+	if !equalInts(removedSinceMark1, []int{0, 2, 4}) {
+		t.Errorf("RemovedSince(mark1) = %v, want %v", removedSinceMark1, []int{0, 2, 4})
+	}
+}
+
+func TestSet_Mark_RemovedSince_empty(t *testing.T) {
+	ss := New(5)
+	mark := ss.Mark()
+	if got := ss.RemovedSince(mark); len(got) != 0 {
+		t.Errorf("RemovedSince(mark) on empty set = %v, want empty", got)
+	}
+}
@@ -0,0 +1,162 @@
+package sparsesets
+
+import "fmt"
+
+// UnionWith sets the receiver to the union of itself and other, i.e. it
+// inserts every element of other into ss. It returns true if ss was modified
+// as a result.
+//
+// UnionWith panics if ss and other do not share the same capacity N.
+func (ss *Set) UnionWith(other *Set) bool {
+	ss.checkSameCapacity(other)
+
+	before := ss.Size()
+	for _, v := range other.Content() {
+		ss.Insert(v)
+	}
+	return ss.Size() != before
+}
+
+// IntersectionWith sets the receiver to the intersection of itself and other,
+// i.e. it removes every element of ss that is not also in other. It returns
+// true if ss was modified as a result.
+//
+// IntersectionWith iterates the smaller of the two sets, so it runs in
+// O(min(ss.Size(), other.Size())).
+//
+// IntersectionWith panics if ss and other do not share the same capacity N.
+func (ss *Set) IntersectionWith(other *Set) bool {
+	ss.checkSameCapacity(other)
+
+	before := ss.Size()
+
+	var common []int
+	if ss.Size() <= other.Size() {
+		for _, v := range ss.Content() {
+			if other.Contains(v) {
+				common = append(common, v)
+			}
+		}
+	} else {
+		for _, v := range other.Content() {
+			if ss.Contains(v) {
+				common = append(common, v)
+			}
+		}
+	}
+
+	if len(common) == before {
+		return false // every element of ss is already in other.
+	}
+	ss.Clear()
+	for _, v := range common {
+		ss.Insert(v)
+	}
+	return true
+}
+
+// DifferenceWith sets the receiver to the difference of itself and other,
+// i.e. it removes every element of other from ss. It returns true if ss was
+// modified as a result.
+//
+// DifferenceWith iterates the smaller of the two sets, so it runs in
+// O(min(ss.Size(), other.Size())).
+//
+// DifferenceWith panics if ss and other do not share the same capacity N.
+func (ss *Set) DifferenceWith(other *Set) bool {
+	ss.checkSameCapacity(other)
+
+	before := ss.Size()
+	if other.Size() < ss.Size() {
+		for _, v := range other.Content() {
+			if ss.Contains(v) {
+				ss.Remove(v)
+			}
+		}
+	} else {
+		for _, v := range append([]int(nil), ss.Content()...) {
+			if other.Contains(v) {
+				ss.Remove(v)
+			}
+		}
+	}
+	return ss.Size() != before
+}
+
+// SymmetricDifferenceWith sets the receiver to the symmetric difference of
+// itself and other, i.e. ss ends up containing the elements that are in
+// exactly one of the two sets. It returns true if ss was modified as a
+// result.
+//
+// SymmetricDifferenceWith panics if ss and other do not share the same
+// capacity N.
+func (ss *Set) SymmetricDifferenceWith(other *Set) bool {
+	ss.checkSameCapacity(other)
+
+	changed := false
+	for _, v := range append([]int(nil), other.Content()...) {
+		if ss.Contains(v) {
+			ss.Remove(v)
+		} else {
+			ss.Insert(v)
+		}
+		changed = true
+	}
+	return changed
+}
+
+// SubsetOf returns true if every element of ss is also in other.
+//
+// SubsetOf panics if ss and other do not share the same capacity N.
+func (ss *Set) SubsetOf(other *Set) bool {
+	ss.checkSameCapacity(other)
+
+	if ss.Size() > other.Size() {
+		return false
+	}
+	for _, v := range ss.Content() {
+		if !other.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersects returns true if ss and other have at least one element in
+// common.
+//
+// Intersects iterates the smaller of the two sets, so it runs in
+// O(min(ss.Size(), other.Size())).
+//
+// Intersects panics if ss and other do not share the same capacity N.
+func (ss *Set) Intersects(other *Set) bool {
+	ss.checkSameCapacity(other)
+
+	smaller, larger := ss, other
+	if larger.Size() < smaller.Size() {
+		smaller, larger = larger, smaller
+	}
+	for _, v := range smaller.Content() {
+		if larger.Contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Equals returns true if ss and other contain exactly the same elements.
+//
+// Equals panics if ss and other do not share the same capacity N.
+func (ss *Set) Equals(other *Set) bool {
+	ss.checkSameCapacity(other)
+	return ss.Size() == other.Size() && ss.SubsetOf(other)
+}
+
+// checkSameCapacity panics if ss and other do not share the same capacity N,
+// since the set-algebra operations assume both sets are drawn from the same
+// universe [0, N).
+func (ss *Set) checkSameCapacity(other *Set) {
+	if ss.N() != other.N() {
+		panic(fmt.Sprintf("mismatched set capacities: %d != %d", ss.N(), other.N()))
+	}
+}
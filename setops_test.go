@@ -0,0 +1,306 @@
+package sparsesets
+
+import (
+	"sort"
+	"testing"
+)
+
+func setOf(n int, elems ...int) *Set {
+	ss := New(n)
+	for _, e := range elems {
+		ss.Insert(e)
+	}
+	return ss
+}
+
+func sortedContent(ss *Set) []int {
+	c := append([]int(nil), ss.Content()...)
+	sort.Ints(c)
+	return c
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSet_UnionWith(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      []int
+		wantElems []int
+		wantDelta bool
+	}{
+		{"empty with empty", nil, nil, nil, false},
+		{"empty with non-empty", nil, []int{1, 2}, []int{1, 2}, true},
+		{"disjoint", []int{0, 1}, []int{2, 3}, []int{0, 1, 2, 3}, true},
+		{"overlapping", []int{0, 1, 2}, []int{1, 2, 3}, []int{0, 1, 2, 3}, true},
+		{"subset", []int{0, 1, 2, 3}, []int{1, 2}, []int{0, 1, 2, 3}, false},
+		{"identical", []int{0, 1}, []int{0, 1}, []int{0, 1}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := setOf(5, tt.a...)
+			b := setOf(5, tt.b...)
+			gotChanged := a.UnionWith(b)
+			if gotChanged != tt.wantDelta {
+				t.Errorf("UnionWith() changed = %v, want %v", gotChanged, tt.wantDelta)
+			}
+			if got := sortedContent(a); !equalInts(got, tt.wantElems) {
+				t.Errorf("UnionWith() content = %v, want %v", got, tt.wantElems)
+			}
+		})
+	}
+}
+
+func TestSet_UnionWith_self(t *testing.T) {
+	a := setOf(5, 0, 2, 4)
+	if a.UnionWith(a) {
+		t.Errorf("UnionWith(self) changed = true, want false")
+	}
+	if got := sortedContent(a); !equalInts(got, []int{0, 2, 4}) {
+		t.Errorf("UnionWith(self) content = %v, want %v", got, []int{0, 2, 4})
+	}
+}
+
+func TestSet_IntersectionWith(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      []int
+		wantElems []int
+		wantDelta bool
+	}{
+		{"empty with empty", nil, nil, nil, false},
+		{"empty with non-empty", nil, []int{1, 2}, nil, false},
+		{"disjoint", []int{0, 1}, []int{2, 3}, nil, true},
+		{"overlapping", []int{0, 1, 2}, []int{1, 2, 3}, []int{1, 2}, true},
+		{"subset", []int{0, 1, 2, 3}, []int{1, 2}, []int{1, 2}, true},
+		{"superset", []int{1, 2}, []int{0, 1, 2, 3}, []int{1, 2}, false},
+		{"identical", []int{0, 1}, []int{0, 1}, []int{0, 1}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := setOf(5, tt.a...)
+			b := setOf(5, tt.b...)
+			gotChanged := a.IntersectionWith(b)
+			if gotChanged != tt.wantDelta {
+				t.Errorf("IntersectionWith() changed = %v, want %v", gotChanged, tt.wantDelta)
+			}
+			if got := sortedContent(a); !equalInts(got, tt.wantElems) {
+				t.Errorf("IntersectionWith() content = %v, want %v", got, tt.wantElems)
+			}
+		})
+	}
+}
+
+func TestSet_IntersectionWith_self(t *testing.T) {
+	a := setOf(5, 0, 2, 4)
+	if a.IntersectionWith(a) {
+		t.Errorf("IntersectionWith(self) changed = true, want false")
+	}
+	if got := sortedContent(a); !equalInts(got, []int{0, 2, 4}) {
+		t.Errorf("IntersectionWith(self) content = %v, want %v", got, []int{0, 2, 4})
+	}
+}
+
+func TestSet_DifferenceWith(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      []int
+		wantElems []int
+		wantDelta bool
+	}{
+		{"empty with empty", nil, nil, nil, false},
+		{"empty with non-empty", nil, []int{1, 2}, nil, false},
+		{"disjoint", []int{0, 1}, []int{2, 3}, []int{0, 1}, false},
+		{"overlapping", []int{0, 1, 2}, []int{1, 2, 3}, []int{0}, true},
+		{"subset removed", []int{0, 1, 2, 3}, []int{1, 2}, []int{0, 3}, true},
+		{"everything removed", []int{1, 2}, []int{0, 1, 2, 3}, nil, true},
+		{"identical", []int{0, 1}, []int{0, 1}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := setOf(5, tt.a...)
+			b := setOf(5, tt.b...)
+			gotChanged := a.DifferenceWith(b)
+			if gotChanged != tt.wantDelta {
+				t.Errorf("DifferenceWith() changed = %v, want %v", gotChanged, tt.wantDelta)
+			}
+			if got := sortedContent(a); !equalInts(got, tt.wantElems) {
+				t.Errorf("DifferenceWith() content = %v, want %v", got, tt.wantElems)
+			}
+		})
+	}
+}
+
+func TestSet_DifferenceWith_self(t *testing.T) {
+	a := setOf(5, 0, 2, 4)
+	if !a.DifferenceWith(a) {
+		t.Errorf("DifferenceWith(self) changed = false, want true")
+	}
+	if got := a.Size(); got != 0 {
+		t.Errorf("DifferenceWith(self) size = %d, want 0", got)
+	}
+}
+
+func TestSet_SymmetricDifferenceWith(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      []int
+		wantElems []int
+		wantDelta bool
+	}{
+		{"empty with empty", nil, nil, nil, false},
+		{"empty with non-empty", nil, []int{1, 2}, []int{1, 2}, true},
+		{"disjoint", []int{0, 1}, []int{2, 3}, []int{0, 1, 2, 3}, true},
+		{"overlapping", []int{0, 1, 2}, []int{1, 2, 3}, []int{0, 3}, true},
+		{"identical", []int{0, 1}, []int{0, 1}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := setOf(5, tt.a...)
+			b := setOf(5, tt.b...)
+			gotChanged := a.SymmetricDifferenceWith(b)
+			if gotChanged != tt.wantDelta {
+				t.Errorf("SymmetricDifferenceWith() changed = %v, want %v", gotChanged, tt.wantDelta)
+			}
+			if got := sortedContent(a); !equalInts(got, tt.wantElems) {
+				t.Errorf("SymmetricDifferenceWith() content = %v, want %v", got, tt.wantElems)
+			}
+		})
+	}
+}
+
+func TestSet_SymmetricDifferenceWith_self(t *testing.T) {
+	a := setOf(5, 0, 2, 4)
+	if !a.SymmetricDifferenceWith(a) {
+		t.Errorf("SymmetricDifferenceWith(self) changed = false, want true")
+	}
+	if got := a.Size(); got != 0 {
+		t.Errorf("SymmetricDifferenceWith(self) size = %d, want 0", got)
+	}
+}
+
+func TestSet_SubsetOf(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want bool
+	}{
+		{"empty subset of empty", nil, nil, true},
+		{"empty subset of non-empty", nil, []int{1, 2}, true},
+		{"non-empty subset of empty", []int{1}, nil, false},
+		{"proper subset", []int{1, 2}, []int{0, 1, 2, 3}, true},
+		{"equal sets", []int{0, 1}, []int{0, 1}, true},
+		{"not a subset", []int{0, 4}, []int{0, 1, 2}, false},
+		{"superset is not a subset", []int{0, 1, 2, 3}, []int{1, 2}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := setOf(5, tt.a...)
+			b := setOf(5, tt.b...)
+			if got := a.SubsetOf(b); got != tt.want {
+				t.Errorf("SubsetOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_SubsetOf_self(t *testing.T) {
+	a := setOf(5, 0, 2, 4)
+	if !a.SubsetOf(a) {
+		t.Errorf("SubsetOf(self) = false, want true")
+	}
+}
+
+func TestSet_Intersects(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want bool
+	}{
+		{"empty with empty", nil, nil, false},
+		{"empty with non-empty", nil, []int{1, 2}, false},
+		{"disjoint", []int{0, 1}, []int{2, 3}, false},
+		{"overlapping", []int{0, 1, 2}, []int{2, 3}, true},
+		{"identical", []int{0, 1}, []int{0, 1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := setOf(5, tt.a...)
+			b := setOf(5, tt.b...)
+			if got := a.Intersects(b); got != tt.want {
+				t.Errorf("Intersects() = %v, want %v", got, tt.want)
+			}
+			if got := b.Intersects(a); got != tt.want {
+				t.Errorf("Intersects() (reversed) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_Intersects_selfEmpty(t *testing.T) {
+	a := New(5)
+	if a.Intersects(a) {
+		t.Errorf("Intersects(self) on empty set = true, want false")
+	}
+}
+
+func TestSet_Equals(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want bool
+	}{
+		{"empty with empty", nil, nil, true},
+		{"empty with non-empty", nil, []int{1}, false},
+		{"same elements", []int{0, 1, 2}, []int{0, 1, 2}, true},
+		{"different elements", []int{0, 1, 2}, []int{0, 1, 3}, false},
+		{"different sizes", []int{0, 1}, []int{0, 1, 2}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := setOf(5, tt.a...)
+			b := setOf(5, tt.b...)
+			if got := a.Equals(b); got != tt.want {
+				t.Errorf("Equals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_Equals_self(t *testing.T) {
+	a := setOf(5, 0, 2, 4)
+	if !a.Equals(a) {
+		t.Errorf("Equals(self) = false, want true")
+	}
+}
+
+func TestSet_setAlgebra_mismatchedCapacityPanics(t *testing.T) {
+	ops := map[string]func(a, b *Set) bool{
+		"UnionWith":               (*Set).UnionWith,
+		"IntersectionWith":        (*Set).IntersectionWith,
+		"DifferenceWith":          (*Set).DifferenceWith,
+		"SymmetricDifferenceWith": (*Set).SymmetricDifferenceWith,
+		"SubsetOf":                (*Set).SubsetOf,
+		"Intersects":              (*Set).Intersects,
+		"Equals":                  (*Set).Equals,
+	}
+	for name, op := range ops {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: want panic on mismatched capacities, got none", name)
+				}
+			}()
+			op(New(5), New(6))
+		})
+	}
+}
@@ -10,6 +10,11 @@
 //   - Clear:    O(1)
 //   - Content:  O(size)
 //   - Absent:   O(N - size)
+//   - Save:     O(1)
+//   - Restore:  O(1)
+//   - Mark:     O(1)
+//   - RemovedSince: O(delta), where delta is the number of elements removed
+//     since the mark
 //
 // This efficiency comes at the cost of increased memory usage, as a sparse set
 // internally utilizes two slices of N integers.
@@ -24,6 +29,7 @@ type Set struct {
 	size      int
 	values    []int
 	positions []int
+	trail     []int
 }
 
 // New creates and initializes a new empty Set for elements from 0 to N-1.
@@ -49,6 +55,17 @@ func New(n int) *Set {
 	return ss
 }
 
+// Grow extends the set's capacity to n, preserving its current elements.
+// Elements added by growing (those in [N(), n)) start absent from the set,
+// exactly as if New(n) had been called and they had never been inserted.
+// Grow is a no-op if n <= N().
+func (ss *Set) Grow(n int) {
+	for i := ss.N(); i < n; i++ {
+		ss.values = append(ss.values, i)
+		ss.positions = append(ss.positions, i)
+	}
+}
+
 // Contains returns true if elem is in the set; it returns false otherwise.
 func (ss *Set) Contains(elem int) bool {
 	return ss.positions[elem] < ss.size
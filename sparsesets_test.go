@@ -49,3 +49,40 @@ func TestSet_Size_empty(t *testing.T) {
 	}
 
 }
+
+func TestSet_Grow(t *testing.T) {
+	ss := New(3)
+	ss.Insert(0)
+	ss.Insert(2)
+
+	ss.Grow(5)
+
+	if got := ss.N(); got != 5 {
+		t.Errorf("N() after Grow(5): want 5, got %d", got)
+	}
+	if got := ss.Size(); got != 2 {
+		t.Errorf("Size() after Grow(5): want 2, got %d", got)
+	}
+	if !ss.Contains(0) || !ss.Contains(2) {
+		t.Errorf("Contains() after Grow(5): original elements lost")
+	}
+	if ss.Contains(3) || ss.Contains(4) {
+		t.Errorf("Contains() after Grow(5): new elements should start absent")
+	}
+
+	if err := ss.Insert(4); err != nil {
+		t.Errorf("Insert(4) after Grow(5): %s", err)
+	}
+	if !ss.Contains(4) {
+		t.Errorf("Contains(4) after Insert(4): want true")
+	}
+}
+
+func TestSet_Grow_noop(t *testing.T) {
+	ss := New(5)
+	ss.Insert(1)
+	ss.Grow(3)
+	if got := ss.N(); got != 5 {
+		t.Errorf("N() after Grow(3) on a capacity-5 set: want 5, got %d", got)
+	}
+}
@@ -0,0 +1,34 @@
+package sparsesets
+
+import "fmt"
+
+// Save records the set's current size onto an internal trail and returns the
+// level identifying this point in time. Save runs in O(1).
+//
+// Pair a call to Save with a later call to Restore(level) to undo, also in
+// O(1), every Remove performed in between: because swap-on-remove keeps
+// values[0:size] exactly equal to the current members regardless of removal
+// order, resetting size back to its recorded value is enough to restore the
+// set.
+//
+// Save/Restore only undoes Remove. Calling Insert between a Save and the
+// matching Restore is not supported and leaves the set in an inconsistent
+// state relative to that trail level.
+func (ss *Set) Save() int {
+	ss.trail = append(ss.trail, ss.size)
+	return len(ss.trail) - 1
+}
+
+// Restore undoes every Remove performed since the Save call that produced
+// level, and discards every trail level recorded after it. Restore runs in
+// O(1).
+//
+// Restore panics if level does not correspond to an outstanding Save, i.e. if
+// it is negative or has already been restored (or gone past).
+func (ss *Set) Restore(level int) {
+	if level < 0 || level >= len(ss.trail) {
+		panic(fmt.Sprintf("invalid trail level %d", level))
+	}
+	ss.size = ss.trail[level]
+	ss.trail = ss.trail[:level]
+}
@@ -0,0 +1,125 @@
+package sparsesets
+
+import "testing"
+
+func TestSet_SaveRestore(t *testing.T) {
+	ss := setOf(5, 0, 1, 2, 3, 4)
+
+	level0 := ss.Save()
+	ss.Remove(1)
+	ss.Remove(3)
+	if got := sortedContent(ss); !equalInts(got, []int{0, 2, 4}) {
+		t.Fatalf("content after removals = %v, want %v", got, []int{0, 2, 4})
+	}
+
+	level1 := ss.Save()
+	ss.Remove(0)
+	if got := sortedContent(ss); !equalInts(got, []int{2, 4}) {
+		t.Fatalf("content after removals = %v, want %v", got, []int{2, 4})
+	}
+
+	ss.Restore(level1)
+	if got := sortedContent(ss); !equalInts(got, []int{0, 2, 4}) {
+		t.Fatalf("content after Restore(level1) = %v, want %v", got, []int{0, 2, 4})
+	}
+
+	ss.Restore(level0)
+	if got := sortedContent(ss); !equalInts(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("content after Restore(level0) = %v, want %v", got, []int{0, 1, 2, 3, 4})
+	}
+}
+
+func TestSet_SaveRestore_nested(t *testing.T) {
+	ss := setOf(10, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	levels := make([]int, 0, 10)
+	for i := 0; i < 10; i++ {
+		levels = append(levels, ss.Save())
+		ss.Remove(i)
+	}
+	if got := ss.Size(); got != 0 {
+		t.Fatalf("size after removing everything = %d, want 0", got)
+	}
+
+	for i := 9; i >= 0; i-- {
+		ss.Restore(levels[i])
+		if got := ss.Size(); got != 10-i {
+			t.Fatalf("size after Restore(levels[%d]) = %d, want %d", i, got, 10-i)
+		}
+	}
+}
+
+func TestSet_Restore_invalidLevel(t *testing.T) {
+	ss := New(5)
+	ss.Save()
+
+	tests := []int{-1, 1, 2}
+	for _, level := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Restore(%d): want panic, got none", level)
+				}
+			}()
+			ss.Restore(level)
+		}()
+	}
+}
+
+func TestSet_Restore_discardsLaterLevels(t *testing.T) {
+	ss := setOf(5, 0, 1, 2, 3, 4)
+
+	level0 := ss.Save()
+	ss.Remove(0)
+	ss.Save()
+	ss.Remove(1)
+
+	ss.Restore(level0)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Restore: want panic on level discarded by a previous Restore, got none")
+		}
+	}()
+	ss.Restore(level0 + 1)
+}
+
+// BenchmarkSet_SaveRestore measures the cost of trialing and undoing the
+// removal of every element of a Set one at a time, as a constraint solver
+// would do while exploring and backtracking over a search tree.
+func BenchmarkSet_SaveRestore(b *testing.B) {
+	const n = 1000
+	ss := New(n)
+	for i := 0; i < n; i++ {
+		ss.Insert(i)
+	}
+
+	for i := 0; i < b.N; i++ {
+		level := ss.Save()
+		for e := 0; e < n; e++ {
+			ss.Remove(e)
+		}
+		ss.Restore(level)
+	}
+}
+
+// BenchmarkMapBacktracking measures the cost of the same trial-and-undo
+// workload using the common map[int]struct{} idiom, copying the map to save
+// it and restoring the copy on backtrack.
+func BenchmarkMapBacktracking(b *testing.B) {
+	const n = 1000
+	base := make(map[int]struct{}, n)
+	for i := 0; i < n; i++ {
+		base[i] = struct{}{}
+	}
+
+	for i := 0; i < b.N; i++ {
+		m := make(map[int]struct{}, len(base))
+		for k, v := range base {
+			m[k] = v
+		}
+		for e := 0; e < n; e++ {
+			delete(m, e)
+		}
+	}
+}